@@ -0,0 +1,271 @@
+package autodiff
+
+import "math"
+
+// DualVec is a forward-mode dual number whose derivative part is a vector,
+// so a single forward pass through a function computes partial derivatives
+// with respect to every input at once, instead of the one-input-at-a-time
+// DualNumber used by Gradient.
+type DualVec struct {
+	Value float64
+	Deriv []float64
+}
+
+// VarVec wraps a DualVec for a user-friendly API, mirroring Variable.
+type VarVec struct {
+	dual DualVec
+}
+
+// NewVarVec creates a new vector-dual variable with the given value and
+// derivative vector.
+func NewVarVec(value float64, deriv []float64) *VarVec {
+	return &VarVec{dual: DualVec{Value: value, Deriv: deriv}}
+}
+
+// NewScalarVec creates a vector-dual constant of n dimensions (all
+// derivative components zero).
+func NewScalarVec(value float64, n int) *VarVec {
+	return &VarVec{dual: DualVec{Value: value, Deriv: make([]float64, n)}}
+}
+
+// Value returns the value of the variable.
+func (v *VarVec) Value() float64 {
+	return v.dual.Value
+}
+
+// Deriv returns the derivative vector of the variable.
+func (v *VarVec) Deriv() []float64 {
+	return v.dual.Deriv
+}
+
+// derivLen returns the broadcast dimension for combining v1 and v2: the
+// length of whichever has a non-empty derivative vector. A zero-length
+// vector (as produced by VarVecFromFloat) behaves like a constant and
+// broadcasts to the other operand's dimension.
+func derivLen(v1, v2 *VarVec) int {
+	n := len(v1.dual.Deriv)
+	if len(v2.dual.Deriv) > n {
+		n = len(v2.dual.Deriv)
+	}
+	return n
+}
+
+// derivAt returns v's k-th derivative component, treating an out-of-range
+// index (v's vector shorter than k, e.g. a VarVecFromFloat constant) as 0.
+func derivAt(v *VarVec, k int) float64 {
+	if k < len(v.dual.Deriv) {
+		return v.dual.Deriv[k]
+	}
+	return 0
+}
+
+// AddVec returns a new variable that is the sum of v1 and v2.
+func AddVec(v1, v2 *VarVec) *VarVec {
+	deriv := make([]float64, derivLen(v1, v2))
+	for k := range deriv {
+		deriv[k] = derivAt(v1, k) + derivAt(v2, k)
+	}
+	return &VarVec{dual: DualVec{Value: v1.dual.Value + v2.dual.Value, Deriv: deriv}}
+}
+
+// SubVec returns a new variable that is v1 minus v2.
+func SubVec(v1, v2 *VarVec) *VarVec {
+	deriv := make([]float64, derivLen(v1, v2))
+	for k := range deriv {
+		deriv[k] = derivAt(v1, k) - derivAt(v2, k)
+	}
+	return &VarVec{dual: DualVec{Value: v1.dual.Value - v2.dual.Value, Deriv: deriv}}
+}
+
+// MulVec returns a new variable that is the product of v1 and v2.
+func MulVec(v1, v2 *VarVec) *VarVec {
+	// Product rule: d(uv) = u'v + uv'
+	deriv := make([]float64, derivLen(v1, v2))
+	for k := range deriv {
+		deriv[k] = derivAt(v1, k)*v2.dual.Value + v1.dual.Value*derivAt(v2, k)
+	}
+	return &VarVec{dual: DualVec{Value: v1.dual.Value * v2.dual.Value, Deriv: deriv}}
+}
+
+// DivVec returns a new variable that is v1 divided by v2.
+func DivVec(v1, v2 *VarVec) *VarVec {
+	// Quotient rule: d(u/v) = (u'v - uv')/v²
+	if v2.dual.Value == 0 {
+		panic("division by zero")
+	}
+	deriv := make([]float64, derivLen(v1, v2))
+	for k := range deriv {
+		deriv[k] = (derivAt(v1, k)*v2.dual.Value - v1.dual.Value*derivAt(v2, k)) / (v2.dual.Value * v2.dual.Value)
+	}
+	return &VarVec{dual: DualVec{Value: v1.dual.Value / v2.dual.Value, Deriv: deriv}}
+}
+
+// NegVec returns a new variable that is the negation of v.
+func NegVec(v *VarVec) *VarVec {
+	deriv := make([]float64, len(v.dual.Deriv))
+	for k, d := range v.dual.Deriv {
+		deriv[k] = -d
+	}
+	return &VarVec{dual: DualVec{Value: -v.dual.Value, Deriv: deriv}}
+}
+
+// AbsVec computes the absolute value of a vector-dual variable.
+func AbsVec(v *VarVec) *VarVec {
+	sign := 1.0
+	if v.dual.Value < 0 {
+		sign = -1.0
+	}
+	deriv := make([]float64, len(v.dual.Deriv))
+	for k, d := range v.dual.Deriv {
+		deriv[k] = sign * d
+	}
+	return &VarVec{dual: DualVec{Value: math.Abs(v.dual.Value), Deriv: deriv}}
+}
+
+// SinVec computes sine of a vector-dual variable.
+func SinVec(v *VarVec) *VarVec {
+	deriv := make([]float64, len(v.dual.Deriv))
+	c := math.Cos(v.dual.Value)
+	for k, d := range v.dual.Deriv {
+		deriv[k] = c * d
+	}
+	return &VarVec{dual: DualVec{Value: math.Sin(v.dual.Value), Deriv: deriv}}
+}
+
+// CosVec computes cosine of a vector-dual variable.
+func CosVec(v *VarVec) *VarVec {
+	deriv := make([]float64, len(v.dual.Deriv))
+	s := -math.Sin(v.dual.Value)
+	for k, d := range v.dual.Deriv {
+		deriv[k] = s * d
+	}
+	return &VarVec{dual: DualVec{Value: math.Cos(v.dual.Value), Deriv: deriv}}
+}
+
+// ExpVec computes the exponential of a vector-dual variable.
+func ExpVec(v *VarVec) *VarVec {
+	val := math.Exp(v.dual.Value)
+	deriv := make([]float64, len(v.dual.Deriv))
+	for k, d := range v.dual.Deriv {
+		deriv[k] = val * d
+	}
+	return &VarVec{dual: DualVec{Value: val, Deriv: deriv}}
+}
+
+// LogVec computes the natural logarithm of a vector-dual variable.
+func LogVec(v *VarVec) *VarVec {
+	if v.dual.Value <= 0 {
+		panic("log of non-positive number")
+	}
+	deriv := make([]float64, len(v.dual.Deriv))
+	for k, d := range v.dual.Deriv {
+		deriv[k] = d / v.dual.Value
+	}
+	return &VarVec{dual: DualVec{Value: math.Log(v.dual.Value), Deriv: deriv}}
+}
+
+// PowVec computes v raised to the power of exponent (constant).
+func PowVec(v *VarVec, exponent float64) *VarVec {
+	if v.dual.Value == 0 && exponent <= 0 {
+		panic("invalid power operation")
+	}
+	powVal := math.Pow(v.dual.Value, exponent-1)
+	deriv := make([]float64, len(v.dual.Deriv))
+	for k, d := range v.dual.Deriv {
+		deriv[k] = exponent * powVal * d
+	}
+	return &VarVec{dual: DualVec{Value: powVal * v.dual.Value, Deriv: deriv}}
+}
+
+// SqrtVec computes the square root of a vector-dual variable.
+func SqrtVec(v *VarVec) *VarVec {
+	return PowVec(v, 0.5)
+}
+
+// PowIVec computes v raised to an integer power of exponent (constant).
+func PowIVec(v *VarVec, exponent int) *VarVec {
+	return PowVec(v, float64(exponent))
+}
+
+// ----- Methods satisfying the Scalar interface -----
+
+func (v *VarVec) Add(v2 *VarVec) *VarVec {
+	return AddVec(v, v2)
+}
+
+func (v *VarVec) Sub(v2 *VarVec) *VarVec {
+	return SubVec(v, v2)
+}
+
+func (v *VarVec) Mul(v2 *VarVec) *VarVec {
+	return MulVec(v, v2)
+}
+
+func (v *VarVec) Div(v2 *VarVec) *VarVec {
+	return DivVec(v, v2)
+}
+
+func (v *VarVec) Neg() *VarVec {
+	return NegVec(v)
+}
+
+func (v *VarVec) Sin() *VarVec {
+	return SinVec(v)
+}
+
+func (v *VarVec) Cos() *VarVec {
+	return CosVec(v)
+}
+
+func (v *VarVec) Exp() *VarVec {
+	return ExpVec(v)
+}
+
+func (v *VarVec) Log() *VarVec {
+	return LogVec(v)
+}
+
+func (v *VarVec) Sqrt() *VarVec {
+	return SqrtVec(v)
+}
+
+func (v *VarVec) Abs() *VarVec {
+	return AbsVec(v)
+}
+
+func (v *VarVec) Pow(exponent float64) *VarVec {
+	return PowVec(v, exponent)
+}
+
+func (v *VarVec) PowI(exponent int) *VarVec {
+	return PowIVec(v, exponent)
+}
+
+func (v *VarVec) Float() float64 {
+	return v.dual.Value
+}
+
+// VarVecFromFloat constructs a vector-dual constant from a plain float64,
+// satisfying the FromFloat convention used to evaluate a model with any
+// Scalar implementation. Its derivative vector starts empty and broadcasts
+// as all-zero against whatever dimension it is combined with (see
+// derivLen/derivAt), since FromFloat has no way to know the model's
+// dimensionality up front.
+func VarVecFromFloat(x float64) *VarVec {
+	return &VarVec{dual: DualVec{Value: x}}
+}
+
+// GradientVec computes the full gradient of f at x in a single forward
+// pass, by seeding x[i].Deriv[i] = 1 for every i and reading all N partial
+// derivatives out of one call to f.
+func GradientVec(f func([]*VarVec) *VarVec, x []float64) []float64 {
+	n := len(x)
+	vars := make([]*VarVec, n)
+	for i := range vars {
+		vars[i] = NewScalarVec(x[i], n)
+		vars[i].dual.Deriv[i] = 1
+	}
+
+	result := f(vars)
+	return result.dual.Deriv
+}
@@ -0,0 +1,104 @@
+package autodiff
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGradientVecMatchesNumerical(t *testing.T) {
+	f := func(vars []*VarVec) *VarVec {
+		return AddVec(MulVec(vars[0], vars[1]), SinVec(vars[0]))
+	}
+	x := []float64{2.0, 3.0}
+
+	got := GradientVec(f, x)
+
+	const h = 1e-6
+	value := func(x []float64) float64 {
+		vars := make([]*VarVec, len(x))
+		for i, xi := range x {
+			vars[i] = NewScalarVec(xi, len(x))
+		}
+		return f(vars).Value()
+	}
+	for i := range x {
+		xp := append([]float64(nil), x...)
+		xp[i] += h
+		xm := append([]float64(nil), x...)
+		xm[i] -= h
+		want := (value(xp) - value(xm)) / (2 * h)
+		if math.Abs(got[i]-want) > 1e-6 {
+			t.Errorf("partial %d: got %v, want %v", i, got[i], want)
+		}
+	}
+}
+
+func TestGradientVecMatchesVariableGradient(t *testing.T) {
+	x := []float64{1.5, -0.5, 2.0}
+
+	wantGrad := VariableGradient(func(vars []*Variable) *Variable {
+		prod := vars[0]
+		for i := 1; i < len(vars); i++ {
+			prod = prod.Mul(vars[i])
+		}
+		return prod
+	}, x)
+
+	gotGrad := GradientVec(func(vars []*VarVec) *VarVec {
+		prod := vars[0]
+		for i := 1; i < len(vars); i++ {
+			prod = prod.Mul(vars[i])
+		}
+		return prod
+	}, x)
+
+	for i := range wantGrad {
+		if math.Abs(gotGrad[i]-wantGrad[i]) > 1e-9 {
+			t.Errorf("partial %d: got %v, want %v", i, gotGrad[i], wantGrad[i])
+		}
+	}
+}
+
+func benchmarkGradientVec(b *testing.B, n int) {
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = float64(i+1) * 0.1
+	}
+	f := func(vars []*VarVec) *VarVec {
+		sum := vars[0]
+		for i := 1; i < len(vars); i++ {
+			sum = sum.Add(vars[i])
+		}
+		return sum
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		GradientVec(f, x)
+	}
+}
+
+func benchmarkVariableGradient(b *testing.B, n int) {
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = float64(i+1) * 0.1
+	}
+	f := func(vars []*Variable) *Variable {
+		sum := vars[0]
+		for i := 1; i < len(vars); i++ {
+			sum = sum.Add(vars[i])
+		}
+		return sum
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		VariableGradient(f, x)
+	}
+}
+
+func BenchmarkGradientVecN2(b *testing.B)   { benchmarkGradientVec(b, 2) }
+func BenchmarkGradientVecN10(b *testing.B)  { benchmarkGradientVec(b, 10) }
+func BenchmarkGradientVecN100(b *testing.B) { benchmarkGradientVec(b, 100) }
+
+func BenchmarkVariableGradientN2(b *testing.B)   { benchmarkVariableGradient(b, 2) }
+func BenchmarkVariableGradientN10(b *testing.B)  { benchmarkVariableGradient(b, 10) }
+func BenchmarkVariableGradientN100(b *testing.B) { benchmarkVariableGradient(b, 100) }
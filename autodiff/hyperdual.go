@@ -0,0 +1,268 @@
+package autodiff
+
+import "math"
+
+// HyperDual represents a hyper-dual number: coefficients on 1, ε1, ε2 and
+// ε1ε2, where ε1² = ε2² = (ε1ε2)² = 0 but ε1ε2 ≠ 0. Propagating a function
+// through a HyperDual yields its value, both first partials and the exact
+// (non-finite-difference) mixed second partial in a single evaluation.
+type HyperDual struct {
+	Real, E1, E2, E1E2 float64
+}
+
+// HVariable wraps a hyper-dual number for a user-friendly API, mirroring
+// Variable.
+type HVariable struct {
+	dual HyperDual
+}
+
+// NewHVariable creates a new hyper-dual variable with the given components.
+func NewHVariable(real, e1, e2, e1e2 float64) *HVariable {
+	return &HVariable{dual: HyperDual{Real: real, E1: e1, E2: e2, E1E2: e1e2}}
+}
+
+// NewHScalar creates a hyper-dual constant (all derivative components zero).
+func NewHScalar(value float64) *HVariable {
+	return &HVariable{dual: HyperDual{Real: value}}
+}
+
+// Value returns the real part of the variable.
+func (v *HVariable) Value() float64 {
+	return v.dual.Real
+}
+
+// HAdd returns a new variable that is the sum of v1 and v2.
+func HAdd(v1, v2 *HVariable) *HVariable {
+	return &HVariable{dual: HyperDual{
+		Real: v1.dual.Real + v2.dual.Real,
+		E1:   v1.dual.E1 + v2.dual.E1,
+		E2:   v1.dual.E2 + v2.dual.E2,
+		E1E2: v1.dual.E1E2 + v2.dual.E1E2,
+	}}
+}
+
+// HSub returns a new variable that is v1 minus v2.
+func HSub(v1, v2 *HVariable) *HVariable {
+	return &HVariable{dual: HyperDual{
+		Real: v1.dual.Real - v2.dual.Real,
+		E1:   v1.dual.E1 - v2.dual.E1,
+		E2:   v1.dual.E2 - v2.dual.E2,
+		E1E2: v1.dual.E1E2 - v2.dual.E1E2,
+	}}
+}
+
+// HMul returns a new variable that is the product of v1 and v2, expanding
+// (a0+a1ε1+a2ε2+a3ε1ε2)(b0+b1ε1+b2ε2+b3ε1ε2) and dropping the ε² terms.
+func HMul(v1, v2 *HVariable) *HVariable {
+	a, b := v1.dual, v2.dual
+	return &HVariable{dual: HyperDual{
+		Real: a.Real * b.Real,
+		E1:   a.Real*b.E1 + a.E1*b.Real,
+		E2:   a.Real*b.E2 + a.E2*b.Real,
+		E1E2: a.Real*b.E1E2 + a.E1*b.E2 + a.E2*b.E1 + a.E1E2*b.Real,
+	}}
+}
+
+// HDiv returns a new variable that is v1 divided by v2, computed as
+// v1 * v2^-1 so the division rule does not need to be derived separately.
+func HDiv(v1, v2 *HVariable) *HVariable {
+	if v2.dual.Real == 0 {
+		panic("division by zero")
+	}
+	return HMul(v1, HPow(v2, -1))
+}
+
+// HNeg returns -v.
+func HNeg(v *HVariable) *HVariable {
+	return &HVariable{dual: HyperDual{
+		Real: -v.dual.Real,
+		E1:   -v.dual.E1,
+		E2:   -v.dual.E2,
+		E1E2: -v.dual.E1E2,
+	}}
+}
+
+// propagate applies a scalar function f (with first and second derivatives
+// fPrime and fDoublePrime, evaluated at v's real part) through the hyper-dual
+// chain rule: f(a) = {f(a0), fPrime(a0)*a1, fPrime(a0)*a2,
+// fDoublePrime(a0)*a1*a2 + fPrime(a0)*a3}.
+func propagate(v *HVariable, f, fPrime, fDoublePrime float64) *HVariable {
+	d := v.dual
+	return &HVariable{dual: HyperDual{
+		Real: f,
+		E1:   fPrime * d.E1,
+		E2:   fPrime * d.E2,
+		E1E2: fDoublePrime*d.E1*d.E2 + fPrime*d.E1E2,
+	}}
+}
+
+// HSin computes sine of a hyper-dual variable.
+func HSin(v *HVariable) *HVariable {
+	x := v.dual.Real
+	return propagate(v, math.Sin(x), math.Cos(x), -math.Sin(x))
+}
+
+// HCos computes cosine of a hyper-dual variable.
+func HCos(v *HVariable) *HVariable {
+	x := v.dual.Real
+	return propagate(v, math.Cos(x), -math.Sin(x), -math.Cos(x))
+}
+
+// HExp computes the exponential of a hyper-dual variable.
+func HExp(v *HVariable) *HVariable {
+	e := math.Exp(v.dual.Real)
+	return propagate(v, e, e, e)
+}
+
+// HLog computes the natural logarithm of a hyper-dual variable.
+func HLog(v *HVariable) *HVariable {
+	if v.dual.Real <= 0 {
+		panic("log of non-positive number")
+	}
+	x := v.dual.Real
+	return propagate(v, math.Log(x), 1/x, -1/(x*x))
+}
+
+// HSqrt computes the square root of a hyper-dual variable.
+func HSqrt(v *HVariable) *HVariable {
+	if v.dual.Real < 0 {
+		panic("sqrt of negative number")
+	}
+	s := math.Sqrt(v.dual.Real)
+	return propagate(v, s, 0.5/s, -0.25/(s*s*s))
+}
+
+// HAbs computes the absolute value of a hyper-dual variable. The first and
+// second derivatives of |x| are taken with respect to the real part's sign,
+// which is well defined everywhere except at x=0.
+func HAbs(v *HVariable) *HVariable {
+	x := v.dual.Real
+	sign := 1.0
+	if x < 0 {
+		sign = -1.0
+	}
+	return propagate(v, math.Abs(x), sign, 0)
+}
+
+// HPow computes v raised to the power of exponent (constant).
+func HPow(v *HVariable, exponent float64) *HVariable {
+	x := v.dual.Real
+	if x == 0 && exponent <= 0 {
+		panic("invalid power operation")
+	}
+	return propagate(v, math.Pow(x, exponent),
+		exponent*math.Pow(x, exponent-1),
+		exponent*(exponent-1)*math.Pow(x, exponent-2))
+}
+
+// HPowI computes v raised to an integer power of exponent (constant).
+func HPowI(v *HVariable, exponent int) *HVariable {
+	return HPow(v, float64(exponent))
+}
+
+// ----- Methods satisfying the Scalar interface -----
+
+func (v *HVariable) Add(v2 *HVariable) *HVariable {
+	return HAdd(v, v2)
+}
+
+func (v *HVariable) Sub(v2 *HVariable) *HVariable {
+	return HSub(v, v2)
+}
+
+func (v *HVariable) Mul(v2 *HVariable) *HVariable {
+	return HMul(v, v2)
+}
+
+func (v *HVariable) Div(v2 *HVariable) *HVariable {
+	return HDiv(v, v2)
+}
+
+func (v *HVariable) Neg() *HVariable {
+	return HNeg(v)
+}
+
+func (v *HVariable) Sin() *HVariable {
+	return HSin(v)
+}
+
+func (v *HVariable) Cos() *HVariable {
+	return HCos(v)
+}
+
+func (v *HVariable) Exp() *HVariable {
+	return HExp(v)
+}
+
+func (v *HVariable) Log() *HVariable {
+	return HLog(v)
+}
+
+func (v *HVariable) Sqrt() *HVariable {
+	return HSqrt(v)
+}
+
+func (v *HVariable) Abs() *HVariable {
+	return HAbs(v)
+}
+
+func (v *HVariable) Pow(exponent float64) *HVariable {
+	return HPow(v, exponent)
+}
+
+func (v *HVariable) PowI(exponent int) *HVariable {
+	return HPowI(v, exponent)
+}
+
+func (v *HVariable) Float() float64 {
+	return v.dual.Real
+}
+
+// Deriv returns the first-order forward derivative component (E1) of v,
+// letting *HVariable plug into the generic Gradient alongside *Variable.
+func (v *HVariable) Deriv() float64 {
+	return v.dual.E1
+}
+
+// HVariableFromFloat constructs a hyper-dual constant from a plain float64,
+// satisfying the FromFloat convention used to evaluate a model with any
+// Scalar implementation.
+func HVariableFromFloat(x float64) *HVariable { return NewHScalar(x) }
+
+// HVariableSeed constructs an *HVariable for use with the generic Gradient:
+// an input (E1 = 1) when isInput, otherwise a constant.
+func HVariableSeed(value float64, isInput bool) *HVariable {
+	v := NewHScalar(value)
+	if isInput {
+		v.dual.E1 = 1
+	}
+	return v
+}
+
+// Hessian computes the Hessian matrix of f at x using exact second
+// derivatives read off the E1E2 component of hyper-dual arithmetic, with no
+// cancellation error from finite differences.
+func Hessian(f func([]*HVariable) *HVariable, x []float64) [][]float64 {
+	n := len(x)
+	h := make([][]float64, n)
+	for i := range h {
+		h[i] = make([]float64, n)
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			vars := make([]*HVariable, n)
+			for k := range vars {
+				vars[k] = NewHScalar(x[k])
+			}
+			vars[i].dual.E1 = 1
+			vars[j].dual.E2 = 1
+
+			result := f(vars)
+			h[i][j] = result.dual.E1E2
+			h[j][i] = h[i][j]
+		}
+	}
+
+	return h
+}
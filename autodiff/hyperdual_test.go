@@ -0,0 +1,134 @@
+package autodiff
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHessianMatchesAnalytic(t *testing.T) {
+	// f(x, y) = x^2*y + sin(x)
+	f := func(vars []*HVariable) *HVariable {
+		return HAdd(HMul(HPow(vars[0], 2), vars[1]), HSin(vars[0]))
+	}
+
+	x, y := 1.3, 2.1
+	h := Hessian(f, []float64{x, y})
+
+	want := [][]float64{
+		{2*y - math.Sin(x), 2 * x},
+		{2 * x, 0},
+	}
+
+	for i := range want {
+		for j := range want[i] {
+			if math.Abs(h[i][j]-want[i][j]) > 1e-9 {
+				t.Errorf("H[%d][%d]: got %v, want %v", i, j, h[i][j], want[i][j])
+			}
+		}
+	}
+}
+
+func TestHessianMatchesAnalyticDiv(t *testing.T) {
+	// f(x, y) = x / y
+	f := func(vars []*HVariable) *HVariable {
+		return HDiv(vars[0], vars[1])
+	}
+
+	x, y := 1.7, 2.3
+	h := Hessian(f, []float64{x, y})
+
+	want := [][]float64{
+		{0, -1 / (y * y)},
+		{-1 / (y * y), 2 * x / (y * y * y)},
+	}
+
+	for i := range want {
+		for j := range want[i] {
+			if math.Abs(h[i][j]-want[i][j]) > 1e-9 {
+				t.Errorf("H[%d][%d]: got %v, want %v", i, j, h[i][j], want[i][j])
+			}
+		}
+	}
+}
+
+func TestHessianMatchesFiniteDifferenceNegSqrtAbsPowI(t *testing.T) {
+	// f(x, y) = PowI(Neg(x), 3) + Sqrt(Abs(y))
+	f := func(vars []*HVariable) *HVariable {
+		return HAdd(HPowI(HNeg(vars[0]), 3), HSqrt(HAbs(vars[1])))
+	}
+
+	x0 := []float64{0.6, 1.4}
+	h := Hessian(f, x0)
+
+	const step = 1e-4
+	value := func(x []float64) float64 {
+		vars := make([]*HVariable, len(x))
+		for i, xi := range x {
+			vars[i] = NewHScalar(xi)
+		}
+		return f(vars).Value()
+	}
+
+	for i := range x0 {
+		for j := range x0 {
+			xpp := append([]float64(nil), x0...)
+			xpp[i] += step
+			xpp[j] += step
+			xpm := append([]float64(nil), x0...)
+			xpm[i] += step
+			xpm[j] -= step
+			xmp := append([]float64(nil), x0...)
+			xmp[i] -= step
+			xmp[j] += step
+			xmm := append([]float64(nil), x0...)
+			xmm[i] -= step
+			xmm[j] -= step
+
+			fd := (value(xpp) - value(xpm) - value(xmp) + value(xmm)) / (4 * step * step)
+			if math.Abs(h[i][j]-fd) > 1e-2 {
+				t.Errorf("H[%d][%d]: exact %v, finite-diff %v", i, j, h[i][j], fd)
+			}
+		}
+	}
+}
+
+func TestHessianMatchesFiniteDifference(t *testing.T) {
+	// f(x, y) = exp(x)*y^3
+	f := func(vars []*HVariable) *HVariable {
+		return HMul(HExp(vars[0]), HPow(vars[1], 3))
+	}
+
+	x0 := []float64{0.4, -0.7}
+	h := Hessian(f, x0)
+
+	const step = 1e-4
+	value := func(x []float64) float64 {
+		vars := make([]*HVariable, len(x))
+		for i, xi := range x {
+			vars[i] = NewHScalar(xi)
+		}
+		return f(vars).Value()
+	}
+
+	for i := range x0 {
+		for j := range x0 {
+			xpp := append([]float64(nil), x0...)
+			xpp[i] += step
+			xpp[j] += step
+			xpm := append([]float64(nil), x0...)
+			xpm[i] += step
+			xpm[j] -= step
+			xmp := append([]float64(nil), x0...)
+			xmp[i] -= step
+			xmp[j] += step
+			xmm := append([]float64(nil), x0...)
+			xmm[i] -= step
+			xmm[j] -= step
+
+			fd := (value(xpp) - value(xpm) - value(xmp) + value(xmm)) / (4 * step * step)
+			if math.Abs(h[i][j]-fd) > 1e-2 {
+				t.Errorf("H[%d][%d]: exact %v, finite-diff %v", i, j, h[i][j], fd)
+			}
+		}
+	}
+}
@@ -0,0 +1,236 @@
+// Package reverse implements reverse-mode automatic differentiation
+// (backpropagation) via a Tape/Node computation graph. Unlike the
+// forward-mode DualNumber in the parent autodiff package, which needs one
+// pass per input to build a full gradient, a single Backward pass here
+// computes partial derivatives with respect to every recorded Var at once.
+package reverse
+
+import (
+	"math"
+
+	"go-ppl/autodiff"
+)
+
+// Node is a single recorded operation on a Tape. Parents holds the indices
+// of the up-to-two operands that produced this node (-1 if absent), and
+// Partials holds the corresponding local derivatives ∂out/∂parent.
+type Node struct {
+	Value    float64
+	Parents  [2]int
+	Partials [2]float64
+}
+
+// Tape records the sequence of operations performed on Vars so that
+// Backward can walk them in reverse and accumulate adjoints.
+type Tape struct {
+	nodes []Node
+}
+
+// NewTape creates a new, empty tape.
+func NewTape() *Tape {
+	return &Tape{}
+}
+
+// Var is a value tracked on a Tape, identified by its node index.
+type Var struct {
+	tape *Tape
+	id   int
+}
+
+var _ autodiff.Scalar[Var] = Var{}
+
+// NewVar registers a new leaf variable (e.g. a model parameter) on the tape.
+func (t *Tape) NewVar(value float64) Var {
+	id := len(t.nodes)
+	t.nodes = append(t.nodes, Node{Value: value, Parents: [2]int{-1, -1}})
+	return Var{tape: t, id: id}
+}
+
+func (t *Tape) push(value float64, p0, p1 int, d0, d1 float64) Var {
+	id := len(t.nodes)
+	t.nodes = append(t.nodes, Node{
+		Value:    value,
+		Parents:  [2]int{p0, p1},
+		Partials: [2]float64{d0, d1},
+	})
+	return Var{tape: t, id: id}
+}
+
+// Value returns the value recorded for v.
+func (v Var) Value() float64 {
+	return v.tape.nodes[v.id].Value
+}
+
+// Add returns v + w, recording the operation on the shared tape.
+func (v Var) Add(w Var) Var {
+	return v.tape.push(v.Value()+w.Value(), v.id, w.id, 1, 1)
+}
+
+// Sub returns v - w, recording the operation on the shared tape.
+func (v Var) Sub(w Var) Var {
+	return v.tape.push(v.Value()-w.Value(), v.id, w.id, 1, -1)
+}
+
+// Mul returns v * w, recording the operation on the shared tape.
+func (v Var) Mul(w Var) Var {
+	return v.tape.push(v.Value()*w.Value(), v.id, w.id, w.Value(), v.Value())
+}
+
+// Div returns v / w, recording the operation on the shared tape.
+func (v Var) Div(w Var) Var {
+	if w.Value() == 0 {
+		panic("division by zero")
+	}
+	wv := w.Value()
+	return v.tape.push(v.Value()/wv, v.id, w.id, 1/wv, -v.Value()/(wv*wv))
+}
+
+// Sin returns sin(v), recording the operation on v's tape.
+func Sin(v Var) Var {
+	return v.tape.push(math.Sin(v.Value()), v.id, -1, math.Cos(v.Value()), 0)
+}
+
+// Cos returns cos(v), recording the operation on v's tape.
+func Cos(v Var) Var {
+	return v.tape.push(math.Cos(v.Value()), v.id, -1, -math.Sin(v.Value()), 0)
+}
+
+// Exp returns exp(v), recording the operation on v's tape.
+func Exp(v Var) Var {
+	val := math.Exp(v.Value())
+	return v.tape.push(val, v.id, -1, val, 0)
+}
+
+// Log returns the natural logarithm of v, recording the operation on v's tape.
+func Log(v Var) Var {
+	if v.Value() <= 0 {
+		panic("log of non-positive number")
+	}
+	return v.tape.push(math.Log(v.Value()), v.id, -1, 1/v.Value(), 0)
+}
+
+// Pow returns v raised to the power of exponent (constant), recording the
+// operation on v's tape.
+func Pow(v Var, exponent float64) Var {
+	if v.Value() == 0 && exponent <= 0 {
+		panic("invalid power operation")
+	}
+	return v.tape.push(math.Pow(v.Value(), exponent), v.id, -1,
+		exponent*math.Pow(v.Value(), exponent-1), 0)
+}
+
+// Neg returns -v, recording the operation on v's tape.
+func Neg(v Var) Var {
+	return v.tape.push(-v.Value(), v.id, -1, -1, 0)
+}
+
+// Sqrt returns the square root of v, recording the operation on v's tape.
+func Sqrt(v Var) Var {
+	return Pow(v, 0.5)
+}
+
+// PowI returns v raised to an integer power of exponent (constant),
+// recording the operation on v's tape.
+func PowI(v Var, exponent int) Var {
+	return Pow(v, float64(exponent))
+}
+
+// Abs returns the absolute value of v, recording the operation on v's tape.
+func Abs(v Var) Var {
+	sign := 1.0
+	if v.Value() < 0 {
+		sign = -1.0
+	}
+	return v.tape.push(math.Abs(v.Value()), v.id, -1, sign, 0)
+}
+
+// ----- Methods satisfying the autodiff.Scalar interface -----
+
+func (v Var) Neg() Var {
+	return Neg(v)
+}
+
+func (v Var) Sin() Var {
+	return Sin(v)
+}
+
+func (v Var) Cos() Var {
+	return Cos(v)
+}
+
+func (v Var) Exp() Var {
+	return Exp(v)
+}
+
+func (v Var) Log() Var {
+	return Log(v)
+}
+
+func (v Var) Sqrt() Var {
+	return Sqrt(v)
+}
+
+func (v Var) Pow(exponent float64) Var {
+	return Pow(v, exponent)
+}
+
+func (v Var) PowI(exponent int) Var {
+	return PowI(v, exponent)
+}
+
+func (v Var) Abs() Var {
+	return Abs(v)
+}
+
+// Float returns the value of v as a plain float64.
+func (v Var) Float() float64 {
+	return v.Value()
+}
+
+// TapeSeed returns a FromFloat-style constructor bound to tape, for use
+// with autodiff.Eval: autodiff.Eval(model, reverse.TapeSeed(tape), x).
+// Var has no context-free FromFloat (unlike VariableFromFloat or
+// HVariableFromFloat) because every Var must be registered on a Tape, so
+// the tape is supplied once up front instead of per call.
+func TapeSeed(tape *Tape) func(float64) Var {
+	return func(x float64) Var {
+		return tape.NewVar(x)
+	}
+}
+
+// Gradient holds the adjoints computed by a single Backward pass, indexed
+// by Var id.
+type Gradient struct {
+	adj []float64
+}
+
+// Backward runs a reverse pass from y over its tape, propagating adjoints
+// from the output back to every recorded Var in one O(len(tape)) sweep.
+func Backward(y Var) *Gradient {
+	t := y.tape
+	adj := make([]float64, len(t.nodes))
+	adj[y.id] = 1
+
+	for i := len(t.nodes) - 1; i >= 0; i-- {
+		a := adj[i]
+		if a == 0 {
+			continue
+		}
+		node := t.nodes[i]
+		for k := 0; k < 2; k++ {
+			p := node.Parents[k]
+			if p < 0 {
+				continue
+			}
+			adj[p] += a * node.Partials[k]
+		}
+	}
+
+	return &Gradient{adj: adj}
+}
+
+// Grad returns ∂y/∂v, where y is the output Backward was run on and v is
+// any Var recorded on the same tape.
+func (g *Gradient) Grad(v Var) float64 {
+	return g.adj[v.id]
+}
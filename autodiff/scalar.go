@@ -0,0 +1,99 @@
+package autodiff
+
+import "math"
+
+// Scalar abstracts over the value types a model can be evaluated with, so a
+// model written once as func[T Scalar[T]](params []T) T can be run with
+// plain floats (value only), forward-mode duals (one gradient component per
+// pass, see Gradient), hyper-duals (exact Hessian entries, see Hessian), or
+// reverse.Var (full gradient in one backward pass, see reverse.Backward)
+// without duplicating the model. Modeled after the num-dual DualNum trait.
+//
+// reverse.Var has no context-free FromFloat constructor, since every Var
+// must be registered on a *reverse.Tape; use reverse.TapeSeed(tape) to get
+// an Eval-compatible constructor bound to a tape instead.
+type Scalar[T any] interface {
+	Add(T) T
+	Sub(T) T
+	Mul(T) T
+	Div(T) T
+	Neg() T
+	Sin() T
+	Cos() T
+	Exp() T
+	Log() T
+	Sqrt() T
+	Pow(exponent float64) T
+	PowI(exponent int) T
+	Abs() T
+	Float() float64
+}
+
+// Float64 is a trivial Scalar wrapping a plain float64, for evaluating a
+// model's value with no derivative tracking at all.
+type Float64 float64
+
+// Float64FromFloat constructs a Float64 from a plain float64.
+func Float64FromFloat(x float64) Float64 { return Float64(x) }
+
+func (f Float64) Add(g Float64) Float64 { return f + g }
+func (f Float64) Sub(g Float64) Float64 { return f - g }
+func (f Float64) Mul(g Float64) Float64 { return f * g }
+func (f Float64) Div(g Float64) Float64 { return f / g }
+func (f Float64) Neg() Float64          { return -f }
+func (f Float64) Sin() Float64          { return Float64(math.Sin(float64(f))) }
+func (f Float64) Cos() Float64          { return Float64(math.Cos(float64(f))) }
+func (f Float64) Exp() Float64          { return Float64(math.Exp(float64(f))) }
+func (f Float64) Log() Float64          { return Float64(math.Log(float64(f))) }
+func (f Float64) Sqrt() Float64         { return Float64(math.Sqrt(float64(f))) }
+func (f Float64) Abs() Float64          { return Float64(math.Abs(float64(f))) }
+func (f Float64) Pow(exponent float64) Float64 {
+	return Float64(math.Pow(float64(f), exponent))
+}
+func (f Float64) PowI(exponent int) Float64 {
+	return Float64(math.Pow(float64(f), float64(exponent)))
+}
+func (f Float64) Float() float64 { return float64(f) }
+
+// Eval evaluates a model function with a concrete Scalar implementation,
+// letting the same model run against plain floats, forward-mode duals,
+// hyper-duals, or reverse-mode nodes depending on the instantiation of T
+// and the from constructor passed in (e.g. Float64FromFloat,
+// VariableFromFloat, HVariableFromFloat, reverse.TapeSeed(tape)).
+func Eval[T Scalar[T]](f func([]T) T, from func(float64) T, x []float64) T {
+	params := make([]T, len(x))
+	for i, xi := range x {
+		params[i] = from(xi)
+	}
+	return f(params)
+}
+
+// Differentiable is a Scalar that also exposes the forward-mode derivative
+// component it carries, letting the generic Gradient below pull a gradient
+// back out of any conforming type (e.g. *Variable, *HVariable) instead of
+// callers having to special-case on the concrete type.
+type Differentiable[T any] interface {
+	Scalar[T]
+	Deriv() float64
+}
+
+// Gradient computes the gradient of a model function at x for any
+// forward-mode Differentiable implementation T, by re-evaluating f once per
+// input with that input seeded to have derivative 1 and all others held
+// constant. seed constructs a parameter of type T from its value and
+// whether it is the active input for this pass (e.g. VariableSeed,
+// HVariableSeed).
+func Gradient[T Differentiable[T]](f func([]T) T, seed func(value float64, isInput bool) T, x []float64) []float64 {
+	grad := make([]float64, len(x))
+
+	for i := range x {
+		vars := make([]T, len(x))
+		for j := range vars {
+			vars[j] = seed(x[j], i == j)
+		}
+
+		grad[i] = f(vars).Deriv()
+	}
+
+	return grad
+}
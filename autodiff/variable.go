@@ -23,6 +23,11 @@ func NewScalar(value float64) *Variable {
 	return &Variable{dual: DualNumber{Value: value, Deriv: 0}}
 }
 
+// VariableFromFloat constructs a variable constant (derivative = 0) from a
+// plain float64, satisfying the FromFloat convention used to evaluate a
+// model with any Scalar implementation.
+func VariableFromFloat(x float64) *Variable { return NewScalar(x) }
+
 // NewInput creates an input variable (derivative = 1)
 func NewInput(value float64) *Variable {
 	return &Variable{dual: DualNumber{Value: value, Deriv: 1}}
@@ -38,27 +43,27 @@ func (v *Variable) Deriv() float64 {
 	return v.dual.Deriv
 }
 
-// Add performs addition v = v + v2
-func (v *Variable) Add(v2 *Variable) {
+// AddInPlace performs addition v = v + v2
+func (v *Variable) AddInPlace(v2 *Variable) {
 	v.dual.Value += v2.dual.Value
 	v.dual.Deriv += v2.dual.Deriv
 }
 
-// Sub performs subtraction v = v - v2
-func (v *Variable) Sub(v2 *Variable) {
+// SubInPlace performs subtraction v = v - v2
+func (v *Variable) SubInPlace(v2 *Variable) {
 	v.dual.Value -= v2.dual.Value
 	v.dual.Deriv -= v2.dual.Deriv
 }
 
-// Mul performs multiplication v = v * v2
-func (v *Variable) Mul(v2 *Variable) {
+// MulInPlace performs multiplication v = v * v2
+func (v *Variable) MulInPlace(v2 *Variable) {
 	// Product rule: d(uv) = u'v + uv'
 	v.dual.Deriv = v.dual.Deriv*v2.dual.Value + v.dual.Value*v2.dual.Deriv
 	v.dual.Value *= v2.dual.Value
 }
 
-// Div performs division v = v / v2
-func (v *Variable) Div(v2 *Variable) {
+// DivInPlace performs division v = v / v2
+func (v *Variable) DivInPlace(v2 *Variable) {
 	// Quotient rule: d(u/v) = (u'v - uv')/v²
 	if v2.dual.Value == 0 {
 		panic("division by zero")
@@ -67,6 +72,30 @@ func (v *Variable) Div(v2 *Variable) {
 	v.dual.Value /= v2.dual.Value
 }
 
+// Add returns v + v2 without modifying v, so *Variable satisfies the Scalar
+// interface. For the original mutating behavior, use AddInPlace.
+func (v *Variable) Add(v2 *Variable) *Variable {
+	return Add(v, v2)
+}
+
+// Sub returns v - v2 without modifying v, so *Variable satisfies the Scalar
+// interface. For the original mutating behavior, use SubInPlace.
+func (v *Variable) Sub(v2 *Variable) *Variable {
+	return Sub(v, v2)
+}
+
+// Mul returns v * v2 without modifying v, so *Variable satisfies the Scalar
+// interface. For the original mutating behavior, use MulInPlace.
+func (v *Variable) Mul(v2 *Variable) *Variable {
+	return Mul(v, v2)
+}
+
+// Div returns v / v2 without modifying v, so *Variable satisfies the Scalar
+// interface. For the original mutating behavior, use DivInPlace.
+func (v *Variable) Div(v2 *Variable) *Variable {
+	return Div(v, v2)
+}
+
 // ----- Elementary Functions -----
 
 // Sin computes sine of variable
@@ -127,6 +156,14 @@ func Add(v1, v2 *Variable) *Variable {
 	}}
 }
 
+// Sub returns a new variable that is v1 minus v2
+func Sub(v1, v2 *Variable) *Variable {
+	return &Variable{dual: DualNumber{
+		Value: v1.dual.Value - v2.dual.Value,
+		Deriv: v1.dual.Deriv - v2.dual.Deriv,
+	}}
+}
+
 // Mul returns a new variable that is the product of v1 and v2
 func Mul(v1, v2 *Variable) *Variable {
 	return &Variable{dual: DualNumber{
@@ -135,28 +172,88 @@ func Mul(v1, v2 *Variable) *Variable {
 	}}
 }
 
+// Div returns a new variable that is v1 divided by v2
+func Div(v1, v2 *Variable) *Variable {
+	if v2.dual.Value == 0 {
+		panic("division by zero")
+	}
+	return &Variable{dual: DualNumber{
+		Value: v1.dual.Value / v2.dual.Value,
+		Deriv: (v1.dual.Deriv*v2.dual.Value - v1.dual.Value*v2.dual.Deriv) / (v2.dual.Value * v2.dual.Value),
+	}}
+}
+
+// Neg returns a new variable that is the negation of v
+func Neg(v *Variable) *Variable {
+	return &Variable{dual: DualNumber{Value: -v.dual.Value, Deriv: -v.dual.Deriv}}
+}
+
+// Sqrt computes the square root of variable
+func Sqrt(v *Variable) *Variable {
+	return Pow(v, 0.5)
+}
+
+// PowI computes v raised to an integer power of exponent (constant)
+func PowI(v *Variable, exponent int) *Variable {
+	return Pow(v, float64(exponent))
+}
+
+// Abs computes the absolute value of variable
+func Abs(v *Variable) *Variable {
+	sign := 1.0
+	if v.dual.Value < 0 {
+		sign = -1.0
+	}
+	return &Variable{dual: DualNumber{Value: abs(v.dual.Value), Deriv: sign * v.dual.Deriv}}
+}
+
+// ----- Methods satisfying the Scalar interface -----
+
+// Neg returns -v.
+func (v *Variable) Neg() *Variable { return Neg(v) }
+
+// Sin returns sin(v).
+func (v *Variable) Sin() *Variable { return Sin(v) }
+
+// Cos returns cos(v).
+func (v *Variable) Cos() *Variable { return Cos(v) }
+
+// Exp returns exp(v).
+func (v *Variable) Exp() *Variable { return Exp(v) }
+
+// Log returns the natural logarithm of v.
+func (v *Variable) Log() *Variable { return Log(v) }
+
+// Sqrt returns the square root of v.
+func (v *Variable) Sqrt() *Variable { return Sqrt(v) }
+
+// Pow returns v raised to the power of exponent.
+func (v *Variable) Pow(exponent float64) *Variable { return Pow(v, exponent) }
+
+// PowI returns v raised to an integer power of exponent.
+func (v *Variable) PowI(exponent int) *Variable { return PowI(v, exponent) }
+
+// Abs returns the absolute value of v.
+func (v *Variable) Abs() *Variable { return Abs(v) }
+
+// Float returns the value of v as a plain float64.
+func (v *Variable) Float() float64 { return v.dual.Value }
+
 // ----- Utility Functions -----
 
-// Gradient computes the gradient of a function at a point
-func Gradient(f func([]*Variable) *Variable, inputs []float64) []float64 {
-	grad := make([]float64, len(inputs))
-
-	for i := range inputs {
-		// Create variables with derivative 1 for the i-th input
-		vars := make([]*Variable, len(inputs))
-		for j := range vars {
-			if i == j {
-				vars[j] = NewInput(inputs[j])
-			} else {
-				vars[j] = NewScalar(inputs[j])
-			}
-		}
-
-		result := f(vars)
-		grad[i] = result.Deriv()
+// VariableSeed constructs a *Variable for use with the generic Gradient: an
+// input (derivative 1) when isInput, otherwise a constant (derivative 0).
+func VariableSeed(value float64, isInput bool) *Variable {
+	if isInput {
+		return NewInput(value)
 	}
+	return NewScalar(value)
+}
 
-	return grad
+// VariableGradient computes the gradient of a function at a point using
+// forward-mode Variables. Equivalent to Gradient(f, VariableSeed, inputs).
+func VariableGradient(f func([]*Variable) *Variable, inputs []float64) []float64 {
+	return Gradient(f, VariableSeed, inputs)
 }
 
 // ----- Math helper functions -----
@@ -180,3 +277,7 @@ func log(x float64) float64 {
 func pow(x, y float64) float64 {
 	return math.Pow(x, y)
 }
+
+func abs(x float64) float64 {
+	return math.Abs(x)
+}
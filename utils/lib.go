@@ -2,6 +2,10 @@ package utils
 
 import "math/rand/v2"
 
+// DefaultPCG creates a *rand.Rand backed by a PCG seeded from global
+// randomness, for one-off use where reproducibility doesn't matter. For
+// deterministic seeding, or to Split/Jump the stream afterwards, seed
+// explicitly with NewPCG instead, which returns the underlying *PCG.
 func DefaultPCG() *rand.Rand {
-	return rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	return NewPCG(rand.Uint64()).Rand()
 }
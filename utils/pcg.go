@@ -0,0 +1,96 @@
+package utils
+
+import "math/rand/v2"
+
+// pcgMult is the 64-bit LCG multiplier of the permuted congruential
+// generator below: state_{n+1} = state_n*pcgMult + inc (mod 2^64).
+const pcgMult uint64 = 6364136223846793005
+
+// splitMix64 is a small, fast stream used only to expand a single uint64
+// seed into the well-mixed state words PCG needs.
+type splitMix64 struct{ state uint64 }
+
+func (s *splitMix64) next() uint64 {
+	s.state += 0x9E3779B97F4A7C15
+	z := s.state
+	z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+	z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+	return z ^ (z >> 31)
+}
+
+// PCG is a 64-bit permuted congruential generator with explicit,
+// inspectable LCG state. math/rand/v2's own PCG hides its state once
+// wrapped in a *rand.Rand, which is fine for one-off use but gives no way
+// to reproduce a run or to derive independent streams for parallel MCMC
+// chains, so NewPCG/Split/Jump operate on *PCG directly rather than on
+// *rand.Rand; call Rand() to get a *rand.Rand for use with the rest of the
+// math/rand/v2 API once seeding/splitting/jumping is done.
+type PCG struct {
+	state uint64
+	inc   uint64
+}
+
+// NewPCG deterministically constructs a PCG from a single seed, expanding
+// it into the two 64-bit state words via SplitMix64.
+func NewPCG(seed uint64) *PCG {
+	sm := splitMix64{state: seed}
+	p := &PCG{inc: sm.next() | 1}
+	p.state = p.state*pcgMult + p.inc
+	p.state += sm.next()
+	p.state = p.state*pcgMult + p.inc
+	return p
+}
+
+// Uint64 advances the LCG by one step and returns a permuted 64-bit output,
+// implementing the rand.Source interface so a PCG can back a *rand.Rand.
+// The output permutation is the same two-round avalanche finalizer used by
+// splitMix64.next above (not a single multiply-xor round, which has
+// noticeably weaker avalanche), applied to the pre-advance state.
+func (p *PCG) Uint64() uint64 {
+	old := p.state
+	p.state = p.state*pcgMult + p.inc
+
+	z := old
+	z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+	z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+	return z ^ (z >> 31)
+}
+
+// Rand returns a *rand.Rand backed by p, for use with the rest of the
+// math/rand/v2 API.
+func (p *PCG) Rand() *rand.Rand {
+	return rand.New(p)
+}
+
+// Split produces n child PCGs with disjoint streams, by hashing a counter
+// into fresh seeds (SplitMix64 of p's current state XOR i) and expanding
+// each into a fresh PCG via NewPCG.
+func Split(p *PCG, n int) []*PCG {
+	children := make([]*PCG, n)
+	for i := range children {
+		sm := splitMix64{state: p.state ^ uint64(i)}
+		children[i] = NewPCG(sm.next())
+	}
+	return children
+}
+
+// Jump advances p's stream by steps draws in O(log steps) time, using
+// PCG's logarithmic-time advance: repeated squaring of the LCG's
+// multiplier and increment. The resulting state matches calling p.Uint64()
+// steps times in a row, without materializing the intermediate draws.
+func Jump(p *PCG, steps uint64) {
+	curMult, curPlus := pcgMult, p.inc
+	accMult, accPlus := uint64(1), uint64(0)
+
+	for steps > 0 {
+		if steps&1 == 1 {
+			accMult *= curMult
+			accPlus = accPlus*curMult + curPlus
+		}
+		curPlus *= curMult + 1
+		curMult *= curMult
+		steps >>= 1
+	}
+
+	p.state = accMult*p.state + accPlus
+}
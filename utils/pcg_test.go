@@ -0,0 +1,79 @@
+package utils
+
+import "testing"
+
+func TestNewPCGReproducible(t *testing.T) {
+	a := NewPCG(42)
+	b := NewPCG(42)
+
+	for i := 0; i < 1000; i++ {
+		x, y := a.Uint64(), b.Uint64()
+		if x != y {
+			t.Fatalf("draw %d diverged: %d != %d", i, x, y)
+		}
+	}
+}
+
+func TestJumpMatchesSequentialDraws(t *testing.T) {
+	const steps = 1000
+
+	a := NewPCG(7)
+	b := NewPCG(7)
+
+	for i := 0; i < steps; i++ {
+		a.Uint64()
+	}
+	Jump(b, steps)
+
+	if a.state != b.state {
+		t.Fatalf("Jump state mismatch: sequential=%d jumped=%d", a.state, b.state)
+	}
+
+	// Confirm the streams continue identically after the jump/sequence.
+	for i := 0; i < 100; i++ {
+		x, y := a.Uint64(), b.Uint64()
+		if x != y {
+			t.Fatalf("draw %d after jump diverged: %d != %d", i, x, y)
+		}
+	}
+}
+
+// TestSplitProducesIndependentStreams runs a chi-squared goodness-of-fit
+// test for uniformity on each split child's low byte, a basic statistical
+// check that the children are not degenerate or correlated copies of each
+// other, then confirms their internal states actually differ.
+func TestSplitProducesIndependentStreams(t *testing.T) {
+	p := NewPCG(123)
+	children := Split(p, 4)
+
+	const draws = 100000
+	const buckets = 256
+
+	for ci, child := range children {
+		counts := make([]int, buckets)
+		for i := 0; i < draws; i++ {
+			counts[byte(child.Uint64())]++
+		}
+
+		expected := float64(draws) / float64(buckets)
+		chiSq := 0.0
+		for _, c := range counts {
+			diff := float64(c) - expected
+			chiSq += diff * diff / expected
+		}
+
+		// 255 degrees of freedom; critical value at alpha=0.001 is ~330.
+		const critical = 330.0
+		if chiSq > critical {
+			t.Errorf("child %d: chi-squared %.1f exceeds critical value %.1f (not uniform)", ci, chiSq, critical)
+		}
+	}
+
+	for i := 0; i < len(children); i++ {
+		for j := i + 1; j < len(children); j++ {
+			if children[i].state == children[j].state && children[i].inc == children[j].inc {
+				t.Errorf("children %d and %d have identical state", i, j)
+			}
+		}
+	}
+}